@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/alizaso97/kancli/storage"
+	"github.com/google/uuid"
+)
+
+// BoardConfig is a board's column layout, persisted separately from its
+// tasks (see storage.BoardConfigPath) so editing columns never touches
+// task data.
+type BoardConfig struct {
+	Columns []storage.Column `json:"columns"`
+}
+
+// defaultColumns is the layout a brand new board starts with.
+func defaultColumns() []storage.Column {
+	return []storage.Column{
+		{ID: uuid.NewString(), Title: "To Do"},
+		{ID: uuid.NewString(), Title: "In Progress"},
+		{ID: uuid.NewString(), Title: "Done"},
+	}
+}
+
+func loadBoardConfig(boardID string) (BoardConfig, error) {
+	path, err := storage.BoardConfigPath(boardID)
+	if err != nil {
+		return BoardConfig{}, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		cfg := BoardConfig{Columns: defaultColumns()}
+		if err := saveBoardConfig(boardID, cfg); err != nil {
+			return BoardConfig{}, err
+		}
+		return cfg, nil
+	}
+	if err != nil {
+		return BoardConfig{}, err
+	}
+	var cfg BoardConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return BoardConfig{}, err
+	}
+	if len(cfg.Columns) == 0 {
+		cfg.Columns = defaultColumns()
+		if err := saveBoardConfig(boardID, cfg); err != nil {
+			return BoardConfig{}, err
+		}
+	}
+	return cfg, nil
+}
+
+func saveBoardConfig(boardID string, cfg BoardConfig) error {
+	path, err := storage.BoardConfigPath(boardID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
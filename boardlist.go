@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/alizaso97/kancli/storage"
+	"github.com/alizaso97/kancli/views"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// boardItem adapts a BoardMeta to list.Item, with its description
+// showing a per-column task count.
+type boardItem struct {
+	id          string
+	name        string
+	description string
+}
+
+func (b boardItem) FilterValue() string { return b.name }
+func (b boardItem) Title() string       { return b.name }
+func (b boardItem) Description() string { return b.description }
+
+// BoardList is the top-level view: every board in the workspace, with
+// keybindings to create, rename, delete, and open one.
+type BoardList struct {
+	list   list.Model
+	stack  *views.Stack
+	format storage.Format
+	size   tea.WindowSizeMsg
+	loaded bool
+
+	// naming is set while the rename/new-board textinput is focused;
+	// renaming holds the board ID being renamed, or "" when naming a
+	// brand new board.
+	naming   bool
+	renaming string
+	input    textinput.Model
+}
+
+// NewBoardList builds the board-list view. stack is shared with every
+// view it pushes (forms, boards) so they can push/pop each other.
+func NewBoardList(stack *views.Stack, format storage.Format) *BoardList {
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Boards"
+	return &BoardList{list: l, stack: stack, format: format, input: textinput.New()}
+}
+
+func (m *BoardList) refresh() {
+	ws, _ := loadWorkspace()
+	items := make([]list.Item, 0, len(ws.Boards))
+	for _, b := range ws.Boards {
+		items = append(items, boardItem{id: b.ID, name: b.Name, description: m.summarize(b.ID)})
+	}
+	m.list.SetItems(items)
+}
+
+// summarize loads a board's tasks just to report how many are in each
+// column.
+func (m *BoardList) summarize(id string) string {
+	cfg, err := loadBoardConfig(id)
+	if err != nil {
+		return ""
+	}
+	path, err := storage.BoardPath(id)
+	if err != nil {
+		return ""
+	}
+	records, err := storage.NewFileStore(path, m.format).Load()
+	if err != nil {
+		return ""
+	}
+
+	counts := make(map[string]int, len(cfg.Columns))
+	for _, r := range records {
+		counts[r.Column]++
+	}
+
+	summary := ""
+	for i, c := range cfg.Columns {
+		if i > 0 {
+			summary += "  "
+		}
+		summary += fmt.Sprintf("%s:%d", c.Title, counts[c.ID])
+	}
+	return summary
+}
+
+func (m BoardList) Init() tea.Cmd { return nil }
+
+func (m BoardList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.loaded {
+		m.refresh()
+		m.loaded = true
+	}
+
+	if m.naming {
+		return m.updateNaming(msg)
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.size = msg
+		m.list.SetSize(msg.Width, msg.Height)
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
+			return m, tea.Quit
+		case "n":
+			m.startNaming("")
+			return m, textinput.Blink
+		case "r":
+			if item, ok := m.list.SelectedItem().(boardItem); ok {
+				m.startNaming(item.id)
+				m.input.SetValue(item.name)
+			}
+			return m, textinput.Blink
+		case "d":
+			if item, ok := m.list.SelectedItem().(boardItem); ok {
+				deleteBoard(item.id)
+				m.refresh()
+			}
+			return m, nil
+		case "enter":
+			if item, ok := m.list.SelectedItem().(boardItem); ok {
+				return m.open(item.id)
+			}
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
+
+func (m *BoardList) startNaming(renaming string) {
+	m.naming = true
+	m.renaming = renaming
+	m.input.SetValue("")
+	m.input.Focus()
+}
+
+func (m BoardList) updateNaming(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc":
+			m.naming = false
+			m.input.Blur()
+			return m, nil
+		case "enter":
+			name := m.input.Value()
+			m.input.Blur()
+			m.naming = false
+			if m.renaming != "" {
+				renameBoard(m.renaming, name)
+			} else {
+				createBoard(name)
+			}
+			m.refresh()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+// open pushes the chosen board onto the view stack.
+func (m BoardList) open(id string) (tea.Model, tea.Cmd) {
+	path, err := storage.BoardPath(id)
+	if err != nil {
+		return m, nil
+	}
+	board := New(storage.NewFileStore(path, m.format), id, m.stack)
+	m.stack.Push(board)
+	return board.Update(m.size)
+}
+
+func (m BoardList) View() string {
+	if m.naming {
+		label := "New board name:"
+		if m.renaming != "" {
+			label = "Rename board:"
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, label, m.input.View())
+	}
+	return m.list.View()
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alizaso97/kancli/views"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Detail is the read-only pane opened by pressing enter on a selected
+// task: its markdown description rendered with glamour, scrollable in a
+// viewport. Press 'e' to edit, 'esc' to close.
+type Detail struct {
+	task     Task
+	viewport viewport.Model
+	stack    *views.Stack
+}
+
+// NewDetail renders task's description and opens it in a viewport sized
+// to fit within width x height.
+func NewDetail(task Task, width, height int, stack *views.Stack) *Detail {
+	vp := viewport.New(width, height)
+
+	body := task.description
+	if renderer, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(width),
+	); err == nil {
+		if rendered, err := renderer.Render(task.description); err == nil {
+			body = rendered
+		}
+	}
+
+	vp.SetContent(detailHeader(task) + "\n" + body)
+	return &Detail{task: task, viewport: vp, stack: stack}
+}
+
+func detailHeader(t Task) string {
+	return fmt.Sprintf("%s\npriority: %s  due: %s  assignee: %s  tags: %s",
+		t.title, t.priority, t.dueDate, t.assignee, strings.Join(t.tags, ", "))
+}
+
+func (d Detail) Init() tea.Cmd { return nil }
+
+func (d Detail) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "esc":
+			return d.stack.Pop(), nil
+		case "e":
+			form := NewEditForm(d.task, d.stack)
+			d.stack.Replace(form)
+			return form, form.Init()
+		}
+	}
+
+	var cmd tea.Cmd
+	d.viewport, cmd = d.viewport.Update(msg)
+	return d, cmd
+}
+
+func (d Detail) View() string {
+	return lipgloss.NewStyle().Padding(1, 2).Render(d.viewport.View())
+}
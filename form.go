@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/alizaso97/kancli/views"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// dueDateLayout is the format the due date field parses against and
+// detail.go's header echoes back.
+const dueDateLayout = "2006-01-02"
+
+// Form collects a task's fields with a huh form group: title,
+// description, priority, tags, due date, and assignee. Submitting
+// creates a new task, or updates one in place when editingID is set.
+type Form struct {
+	huh *huh.Form
+
+	columnID  string
+	editingID string
+	stack     *views.Stack
+
+	title       string
+	description string
+	priority    string
+	tags        []string
+	dueDate     string
+	assignee    string
+	createdAt   time.Time
+}
+
+var tagOptions = []huh.Option[string]{
+	huh.NewOption("bug", "bug"),
+	huh.NewOption("feature", "feature"),
+	huh.NewOption("chore", "chore"),
+	huh.NewOption("docs", "docs"),
+}
+
+// NewForm builds a form for creating a task in columnID.
+func NewForm(columnID string, stack *views.Stack) *Form {
+	f := &Form{columnID: columnID, stack: stack, priority: "med"}
+	f.huh = f.build()
+	return f
+}
+
+// NewEditForm builds a form pre-populated from task, for editing it in
+// place.
+func NewEditForm(task Task, stack *views.Stack) *Form {
+	f := &Form{
+		columnID:    task.columnID,
+		editingID:   task.id,
+		stack:       stack,
+		title:       task.title,
+		description: task.description,
+		priority:    task.priority,
+		tags:        append([]string(nil), task.tags...),
+		dueDate:     task.dueDate,
+		assignee:    task.assignee,
+		createdAt:   task.createdAt,
+	}
+	if f.priority == "" {
+		f.priority = "med"
+	}
+	f.huh = f.build()
+	return f
+}
+
+func (f *Form) build() *huh.Form {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().Title("Title").Value(&f.title),
+			huh.NewText().Title("Description (markdown)").Value(&f.description),
+			huh.NewSelect[string]().Title("Priority").
+				Options(
+					huh.NewOption("Low", "low"),
+					huh.NewOption("Medium", "med"),
+					huh.NewOption("High", "high"),
+				).
+				Value(&f.priority),
+			huh.NewMultiSelect[string]().Title("Tags").
+				Options(tagOptions...).
+				Value(&f.tags),
+			huh.NewInput().Title("Due date (YYYY-MM-DD)").Value(&f.dueDate).Validate(validateDueDate),
+			huh.NewInput().Title("Assignee").Value(&f.assignee),
+		),
+	)
+}
+
+// validateDueDate accepts a blank field (no due date) or one matching
+// dueDateLayout, rejecting anything huh shouldn't let the form submit.
+func validateDueDate(s string) error {
+	if s == "" {
+		return nil
+	}
+	if _, err := time.Parse(dueDateLayout, s); err != nil {
+		return fmt.Errorf("due date must look like %s", dueDateLayout)
+	}
+	return nil
+}
+
+// CreateTask builds the Task this form currently describes.
+func (f Form) CreateTask() Task {
+	t := NewTask(f.columnID, f.title, f.description)
+	t.priority = f.priority
+	t.tags = f.tags
+	t.dueDate = f.dueDate
+	t.assignee = f.assignee
+	if f.editingID != "" {
+		t.id = f.editingID
+		t.createdAt = f.createdAt
+	}
+	return t
+}
+
+func (f Form) Init() tea.Cmd { return f.huh.Init() }
+
+func (f Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "ctrl+c" {
+		return f, tea.Quit
+	}
+
+	next, cmd := f.huh.Update(msg)
+	f.huh = next.(*huh.Form)
+
+	if f.huh.State != huh.StateCompleted {
+		return f, cmd
+	}
+
+	task := f.CreateTask()
+	board := f.stack.Pop()
+	next2, saveCmd := board.Update(task)
+	f.stack.Replace(next2)
+	return f.stack.Current(), tea.Batch(cmd, saveCmd)
+}
+
+func (f Form) View() string {
+	return f.huh.View()
+}
@@ -0,0 +1,99 @@
+package io
+
+import (
+	"encoding/csv"
+	"strings"
+
+	"github.com/alizaso97/kancli/storage"
+)
+
+// CSVCodec matches the GitHub Projects export/import schema: Title,
+// Status, Labels, Assignees, Body. Priority and DueDate ride along as
+// two trailing columns GitHub Projects itself doesn't use, so a
+// kancli-authored file round-trips without loss while still importing
+// cleanly from a genuine GitHub Projects export (which simply won't
+// have them).
+type CSVCodec struct{}
+
+var csvHeader = []string{"Title", "Status", "Labels", "Assignees", "Body", "Priority", "DueDate"}
+
+// Encode renders columns and their tasks as CSV.
+func (CSVCodec) Encode(columns []storage.Column, tasks []storage.Task) string {
+	titleByID := make(map[string]string, len(columns))
+	for _, c := range columns {
+		titleByID[c.ID] = c.Title
+	}
+
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	w.Write(csvHeader)
+	for _, t := range tasks {
+		w.Write([]string{
+			t.Title,
+			titleByID[t.Column],
+			strings.Join(t.Tags, ", "),
+			t.Assignee,
+			t.Description,
+			t.Priority,
+			t.DueDate,
+		})
+	}
+	w.Flush()
+	return b.String()
+}
+
+// Decode parses a GitHub Projects CSV export, or one produced by
+// Encode, into columns and tasks. A Status value with no matching
+// column is created, same as Markdown. Priority and DueDate are left
+// blank when the file doesn't carry them.
+func (CSVCodec) Decode(data string, existing []storage.Column) ([]storage.Column, []storage.Task, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return existing, nil, nil
+	}
+
+	columns := append([]storage.Column(nil), existing...)
+	columnID := func(status string) string {
+		for _, c := range columns {
+			if c.Title == status {
+				return c.ID
+			}
+		}
+		id := newColumnID()
+		columns = append(columns, storage.Column{ID: id, Title: status})
+		return id
+	}
+
+	var tasks []storage.Task
+	for _, row := range records[1:] { // skip header
+		if len(row) < 5 {
+			continue
+		}
+
+		var tags []string
+		if row[2] != "" {
+			for _, tag := range strings.Split(row[2], ",") {
+				tags = append(tags, strings.TrimSpace(tag))
+			}
+		}
+
+		task := storage.Task{
+			Title:       row[0],
+			Column:      columnID(row[1]),
+			Tags:        tags,
+			Assignee:    row[3],
+			Description: row[4],
+		}
+		if len(row) > 5 {
+			task.Priority = row[5]
+		}
+		if len(row) > 6 {
+			task.DueDate = row[6]
+		}
+		tasks = append(tasks, task)
+	}
+	return columns, tasks, nil
+}
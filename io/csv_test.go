@@ -0,0 +1,20 @@
+package io
+
+import "testing"
+
+func TestCSVCodecEncode(t *testing.T) {
+	got := CSVCodec{}.Encode(testColumns, testTasks)
+	want := readGolden(t, "board.csv")
+	if got != want {
+		t.Errorf("Encode() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestCSVCodecDecode(t *testing.T) {
+	columns, tasks, err := CSVCodec{}.Decode(readGolden(t, "board.csv"), testColumns)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	assertColumns(t, columns, testColumns)
+	assertTasks(t, tasks, testTasks)
+}
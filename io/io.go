@@ -0,0 +1,12 @@
+// Package io converts a board to and from interchange formats: a
+// GitHub-style task-list Markdown file, and the CSV schema GitHub
+// Projects exports/imports.
+package io
+
+import "github.com/google/uuid"
+
+// newColumnID mints an ID for a column discovered during Decode that
+// doesn't already exist on the board.
+func newColumnID() string {
+	return uuid.NewString()
+}
@@ -0,0 +1,143 @@
+package io
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/alizaso97/kancli/storage"
+)
+
+// MarkdownCodec reads and writes a board as GitHub-style task-list
+// markdown: one "## Column" heading per column, with
+// "- [ ] Title — description" bullets underneath. Priority, due date,
+// assignee, and tags ride along as indented "  - key: value" lines so a
+// kancli-authored file round-trips without loss, as long as each
+// description fits on one line: the description is written on the
+// bullet's line, and Decode does not read the lines that follow a
+// multi-line description back into it.
+type MarkdownCodec struct{}
+
+// Encode renders columns and their tasks as markdown.
+func (MarkdownCodec) Encode(columns []storage.Column, tasks []storage.Task) string {
+	byColumn := make(map[string][]storage.Task, len(columns))
+	for _, t := range tasks {
+		byColumn[t.Column] = append(byColumn[t.Column], t)
+	}
+
+	var b strings.Builder
+	for _, c := range columns {
+		fmt.Fprintf(&b, "## %s\n", c.Title)
+		for _, t := range byColumn[c.ID] {
+			box := " "
+			if strings.EqualFold(c.Title, "done") {
+				box = "x"
+			}
+			if t.Description != "" {
+				fmt.Fprintf(&b, "- [%s] %s — %s\n", box, t.Title, t.Description)
+			} else {
+				fmt.Fprintf(&b, "- [%s] %s\n", box, t.Title)
+			}
+			for _, meta := range encodeMeta(t) {
+				fmt.Fprintf(&b, "  - %s\n", meta)
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// encodeMeta renders a task's optional fields as "key: value" lines,
+// omitting any field that's empty.
+func encodeMeta(t storage.Task) []string {
+	var lines []string
+	if t.Priority != "" {
+		lines = append(lines, "priority: "+t.Priority)
+	}
+	if t.DueDate != "" {
+		lines = append(lines, "due: "+t.DueDate)
+	}
+	if t.Assignee != "" {
+		lines = append(lines, "assignee: "+t.Assignee)
+	}
+	if len(t.Tags) > 0 {
+		lines = append(lines, "tags: "+strings.Join(t.Tags, ", "))
+	}
+	return lines
+}
+
+// Decode parses markdown produced by Encode (or any GitHub-style
+// task-list file) into columns and tasks. Columns in md that aren't
+// already present in existing are appended with a freshly minted ID.
+func (MarkdownCodec) Decode(md string, existing []storage.Column) ([]storage.Column, []storage.Task) {
+	columns := append([]storage.Column(nil), existing...)
+	columnID := func(title string) string {
+		for _, c := range columns {
+			if c.Title == title {
+				return c.ID
+			}
+		}
+		id := newColumnID()
+		columns = append(columns, storage.Column{ID: id, Title: title})
+		return id
+	}
+
+	var tasks []storage.Task
+	var currentColumn string
+	scanner := bufio.NewScanner(strings.NewReader(md))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		switch {
+		case strings.HasPrefix(line, "## "):
+			currentColumn = columnID(strings.TrimSpace(strings.TrimPrefix(line, "## ")))
+		case strings.HasPrefix(line, "- ["):
+			title, description := parseBullet(line)
+			if title == "" {
+				continue
+			}
+			tasks = append(tasks, storage.Task{
+				Column:      currentColumn,
+				Title:       title,
+				Description: description,
+			})
+		case strings.HasPrefix(line, "  - ") && len(tasks) > 0:
+			applyMeta(&tasks[len(tasks)-1], strings.TrimPrefix(line, "  - "))
+		}
+	}
+	return columns, tasks
+}
+
+// parseBullet splits "- [ ] Title — description" into its title and
+// description, tolerating bullets with no description.
+func parseBullet(line string) (title, description string) {
+	end := strings.Index(line, "]")
+	if end < 0 || end+2 > len(line) {
+		return "", ""
+	}
+	rest := strings.TrimSpace(line[end+1:])
+	if parts := strings.SplitN(rest, " — ", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return rest, ""
+}
+
+// applyMeta parses one "key: value" metadata line produced by
+// encodeMeta into the task's matching field.
+func applyMeta(t *storage.Task, line string) {
+	key, value, ok := strings.Cut(line, ": ")
+	if !ok {
+		return
+	}
+	switch key {
+	case "priority":
+		t.Priority = value
+	case "due":
+		t.DueDate = value
+	case "assignee":
+		t.Assignee = value
+	case "tags":
+		for _, tag := range strings.Split(value, ",") {
+			t.Tags = append(t.Tags, strings.TrimSpace(tag))
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package io
+
+import (
+	"os"
+	"testing"
+
+	"github.com/alizaso97/kancli/storage"
+)
+
+func TestMarkdownCodecEncode(t *testing.T) {
+	got := MarkdownCodec{}.Encode(testColumns, testTasks)
+	want := readGolden(t, "board.md")
+	if got != want {
+		t.Errorf("Encode() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestMarkdownCodecDecode(t *testing.T) {
+	columns, tasks := MarkdownCodec{}.Decode(readGolden(t, "board.md"), testColumns)
+	assertColumns(t, columns, testColumns)
+	assertTasks(t, tasks, testTasks)
+}
+
+func readGolden(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("reading golden file %s: %v", name, err)
+	}
+	return string(data)
+}
+
+var testColumns = []storage.Column{
+	{ID: "col-todo", Title: "To Do"},
+	{ID: "col-done", Title: "Done"},
+}
+
+// testTasks exercises every field a codec round-trips: t1 carries the
+// full optional set (priority, due date, assignee, tags), t2 carries
+// none of them, so Decode must leave those fields blank rather than
+// inventing placeholder values.
+var testTasks = []storage.Task{
+	{
+		Column:      "col-todo",
+		Title:       "Write docs",
+		Description: "cover the new codecs",
+		Priority:    "high",
+		Tags:        []string{"docs", "chore"},
+		DueDate:     "2026-08-01",
+		Assignee:    "alice",
+	},
+	{
+		Column: "col-done",
+		Title:  "Ship it",
+	},
+}
+
+func assertColumns(t *testing.T, got, want []storage.Column) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("columns = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Title != want[i].Title {
+			t.Errorf("column %d title = %q, want %q", i, got[i].Title, want[i].Title)
+		}
+	}
+}
+
+func assertTasks(t *testing.T, got, want []storage.Task) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("tasks = %d, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.Title != w.Title || g.Description != w.Description || g.Column != w.Column ||
+			g.Priority != w.Priority || g.DueDate != w.DueDate || g.Assignee != w.Assignee {
+			t.Errorf("task %d = %+v, want %+v", i, g, w)
+		}
+		if len(g.Tags) != len(w.Tags) {
+			t.Fatalf("task %d tags = %v, want %v", i, g.Tags, w.Tags)
+		}
+		for j := range w.Tags {
+			if g.Tags[j] != w.Tags[j] {
+				t.Errorf("task %d tag %d = %q, want %q", i, j, g.Tags[j], w.Tags[j])
+			}
+		}
+	}
+}
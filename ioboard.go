@@ -0,0 +1,176 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	kanio "github.com/alizaso97/kancli/io"
+	"github.com/alizaso97/kancli/storage"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+)
+
+// exportMsg asks the focused board to write itself to path in format
+// ("markdown" or "csv").
+type exportMsg struct{ path, format string }
+
+// importMsg asks the focused board to merge tasks read from path.
+type importMsg struct{ path, format string }
+
+// exportTo encodes the board and writes it to path.
+func (m *Model) exportTo(path, format string) error {
+	var body string
+	switch format {
+	case "csv":
+		body = (kanio.CSVCodec{}).Encode(m.columns, m.collectTasks())
+	default:
+		body = (kanio.MarkdownCodec{}).Encode(m.columns, m.collectTasks())
+	}
+	return os.WriteFile(path, []byte(body), 0o644)
+}
+
+// importFrom reads path and merges its columns and tasks into the
+// board.
+func (m *Model) importFrom(path, format string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var columns []storage.Column
+	var tasks []storage.Task
+	switch format {
+	case "csv":
+		columns, tasks, err = (kanio.CSVCodec{}).Decode(string(data), m.columns)
+		if err != nil {
+			return err
+		}
+	default:
+		columns, tasks = (kanio.MarkdownCodec{}).Decode(string(data), m.columns)
+	}
+
+	m.mergeColumns(columns)
+	for _, r := range tasks {
+		m.mergeTask(r)
+	}
+	return nil
+}
+
+// mergeColumns appends any column the import introduced that the board
+// doesn't already have, and persists the resulting layout.
+func (m *Model) mergeColumns(columns []storage.Column) {
+	known := make(map[string]bool, len(m.columns))
+	for _, c := range m.columns {
+		known[c.ID] = true
+	}
+
+	for _, c := range columns {
+		if known[c.ID] {
+			continue
+		}
+		m.columns = append(m.columns, c)
+		l := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+		l.SetShowHelp(false)
+		l.Title = c.Title
+		m.lists = append(m.lists, l)
+	}
+
+	m.recomputeLayout()
+	saveBoardConfig(m.boardID, BoardConfig{Columns: m.columns})
+}
+
+// mergeTask merges an imported record onto the board. A record that
+// matches an existing task (by ID, or by title within the same column
+// when the source format carries no ID) updates only the fields the
+// import actually carries, preserving everything else on the existing
+// task — including its ID and CreatedAt — so a round-trip through a
+// format that only encodes some fields can't zero out the rest. An
+// unmatched record becomes a new task.
+func (m *Model) mergeTask(r storage.Task) {
+	existing, ok := m.findByID(r.ID)
+	if !ok {
+		existing, ok = m.findByTitle(r.Column, r.Title)
+	}
+
+	if !ok {
+		t := taskFromRecord(r)
+		t.id = uuid.NewString()
+		t.createdAt = time.Now()
+		t.updatedAt = t.createdAt
+		m.upsertTask(t)
+		return
+	}
+
+	existing.title = r.Title
+	existing.description = r.Description
+	existing.columnID = r.Column
+	if r.Priority != "" {
+		existing.priority = r.Priority
+	}
+	if len(r.Tags) > 0 {
+		existing.tags = r.Tags
+	}
+	if r.DueDate != "" {
+		existing.dueDate = r.DueDate
+	}
+	if r.Assignee != "" {
+		existing.assignee = r.Assignee
+	}
+	existing.updatedAt = time.Now()
+	m.upsertTask(existing)
+}
+
+// findByID looks for a task with the given id, wherever it currently
+// lives.
+func (m *Model) findByID(id string) (Task, bool) {
+	if id == "" {
+		return Task{}, false
+	}
+	for _, l := range m.lists {
+		for _, item := range l.Items() {
+			if t, ok := item.(Task); ok && t.id == id {
+				return t, true
+			}
+		}
+	}
+	return Task{}, false
+}
+
+// findByTitle looks for a task named title in columnID.
+func (m *Model) findByTitle(columnID, title string) (Task, bool) {
+	idx := m.columnIndex(columnID)
+	if idx < 0 {
+		return Task{}, false
+	}
+	for _, item := range m.lists[idx].Items() {
+		if t, ok := item.(Task); ok && t.title == title {
+			return t, true
+		}
+	}
+	return Task{}, false
+}
+
+// applyExportImport handles the result of an export or import request,
+// surfacing errors the same way a WIP-limit rejection is: the status
+// bar warning.
+func (m *Model) applyExportImport(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case exportMsg:
+		if err := m.exportTo(msg.path, msg.format); err != nil {
+			m.warning = err.Error()
+		} else {
+			m.warning = fmt.Sprintf("exported to %s", msg.path)
+		}
+		return nil
+	case importMsg:
+		if err := m.importFrom(msg.path, msg.format); err != nil {
+			m.warning = err.Error()
+			return nil
+		}
+		m.warning = fmt.Sprintf("imported from %s", msg.path)
+		return m.scheduleSave()
+	}
+	return nil
+}
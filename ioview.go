@@ -0,0 +1,108 @@
+package main
+
+import (
+	"github.com/alizaso97/kancli/views"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+var formatOptions = []huh.Option[string]{
+	huh.NewOption("Markdown", "markdown"),
+	huh.NewOption("CSV (GitHub Projects)", "csv"),
+}
+
+// ExportView asks for a format and a destination path, then hands an
+// exportMsg back to the board.
+type ExportView struct {
+	huh *huh.Form
+
+	stack  *views.Stack
+	format string
+	path   string
+}
+
+// NewExportView builds the export prompt.
+func NewExportView(stack *views.Stack) *ExportView {
+	v := &ExportView{stack: stack, format: "markdown", path: "board.md"}
+	v.huh = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().Title("Format").
+				Options(formatOptions...).
+				Value(&v.format),
+			huh.NewInput().Title("Export to").Value(&v.path),
+		),
+	)
+	return v
+}
+
+func (v ExportView) Init() tea.Cmd { return v.huh.Init() }
+
+func (v ExportView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "ctrl+c" {
+		return v, tea.Quit
+	}
+
+	next, cmd := v.huh.Update(msg)
+	v.huh = next.(*huh.Form)
+
+	if v.huh.State != huh.StateCompleted {
+		return v, cmd
+	}
+
+	board := v.stack.Pop()
+	next2, applyCmd := board.Update(exportMsg{path: v.path, format: v.format})
+	v.stack.Replace(next2)
+	return v.stack.Current(), tea.Batch(cmd, applyCmd)
+}
+
+func (v ExportView) View() string {
+	return v.huh.View()
+}
+
+// ImportView asks for a format and a source path, then hands an
+// importMsg back to the board.
+type ImportView struct {
+	huh *huh.Form
+
+	stack  *views.Stack
+	format string
+	path   string
+}
+
+// NewImportView builds the import prompt.
+func NewImportView(stack *views.Stack) *ImportView {
+	v := &ImportView{stack: stack, format: "markdown"}
+	v.huh = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().Title("Format").
+				Options(formatOptions...).
+				Value(&v.format),
+			huh.NewFilePicker().Title("Import from").Value(&v.path),
+		),
+	)
+	return v
+}
+
+func (v ImportView) Init() tea.Cmd { return v.huh.Init() }
+
+func (v ImportView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "ctrl+c" {
+		return v, tea.Quit
+	}
+
+	next, cmd := v.huh.Update(msg)
+	v.huh = next.(*huh.Form)
+
+	if v.huh.State != huh.StateCompleted {
+		return v, cmd
+	}
+
+	board := v.stack.Pop()
+	next2, applyCmd := board.Update(importMsg{path: v.path, format: v.format})
+	v.stack.Replace(next2)
+	return v.stack.Current(), tea.Batch(cmd, applyCmd)
+}
+
+func (v ImportView) View() string {
+	return v.huh.View()
+}
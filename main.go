@@ -1,280 +1,609 @@
-package main
-
-import (
-	"fmt"
-	"os"
-
-	"github.com/charmbracelet/bubbles/list"
-	"github.com/charmbracelet/bubbles/textarea"
-	"github.com/charmbracelet/bubbles/textinput"
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
-)
-
-type status int
-
-const divisor = 4
-
-const (
-	todo status = iota
-	inProgress
-	done
-)
-
-// Model Management
-var models []tea.Model
-
-const (
-	model status = iota
-	form
-)
-
-// Styling
-var (
-	columnStyle = lipgloss.NewStyle().
-			Padding(1, 2)
-	focusedStyle = lipgloss.NewStyle().
-			Padding(1, 2).
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("62"))
-)
-
-// Task definition
-type Task struct {
-	status      status
-	title       string
-	description string
-}
-
-func NewTask(status status, title, description string) Task {
-	return Task{status: status, title: title, description: description}
-}
-
-func (t *Task) Next() {
-	if t.status == done {
-		t.status = todo
-	} else {
-		t.status++
-	}
-}
-
-// Implement list.Item interface
-func (t Task) FilterValue() string { return t.title }
-func (t Task) Title() string       { return t.title }
-func (t Task) Description() string { return t.description }
-
-// Main model
-type Model struct {
-	loaded   bool
-	focused  status
-	lists    []list.Model
-	quitting bool
-}
-
-// New main model
-func New() *Model {
-	return &Model{}
-}
-
-// Move task to the next list
-func (m *Model) MoveToNext() tea.Msg {
-	selectedItem := m.lists[m.focused].SelectedItem()
-	if selectedItem == nil {
-		return nil
-	}
-	selectedTask := selectedItem.(Task)
-
-	// Remove from current list
-	m.lists[m.focused].RemoveItem(m.lists[m.focused].Index())
-
-	// Advance status
-	selectedTask.Next()
-
-	// Insert into new list at the end
-	targetList := m.lists[selectedTask.status]
-	targetList.InsertItem(len(targetList.Items()), list.Item(selectedTask))
-
-	// Switch focus to the new list
-	m.focused = selectedTask.status
-
-	return nil
-}
-
-// Delete selected task
-func (m *Model) DeleteTask() tea.Msg {
-	if selectedItem := m.lists[m.focused].SelectedItem(); selectedItem != nil {
-		m.lists[m.focused].RemoveItem(m.lists[m.focused].Index())
-	}
-	return nil
-}
-
-// Change focus
-func (m *Model) Next() {
-	if m.focused == done {
-		m.focused = todo
-	} else {
-		m.focused++
-	}
-}
-func (m *Model) Prev() {
-	if m.focused == todo {
-		m.focused = done
-	} else {
-		m.focused--
-	}
-}
-
-// Initialize lists (empty) and disable the default help panel
-func (m *Model) initLists(width, height int) {
-	listWidth := width / divisor
-	listHeight := height / 2 // start smaller, adjust dynamically
-	m.lists = []list.Model{
-		list.New([]list.Item{}, list.NewDefaultDelegate(), listWidth, listHeight),
-		list.New([]list.Item{}, list.NewDefaultDelegate(), listWidth, listHeight),
-		list.New([]list.Item{}, list.NewDefaultDelegate(), listWidth, listHeight),
-	}
-
-	for i := range m.lists {
-		m.lists[i].SetShowHelp(false) // REMOVE the default help/menu
-	}
-
-	m.lists[todo].Title = "To Do"
-	m.lists[inProgress].Title = "In Progress"
-	m.lists[done].Title = "Done"
-}
-
-func (m Model) Init() tea.Cmd { return nil }
-
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		if !m.loaded {
-			columnStyle.Width(msg.Width / divisor)
-			focusedStyle.Width(msg.Width / divisor)
-			columnStyle.Height(msg.Height / 2)  // dynamic smaller height
-			focusedStyle.Height(msg.Height / 2) // dynamic smaller height
-			m.initLists(msg.Width, msg.Height)
-			m.loaded = true
-		}
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
-			m.quitting = true
-			return m, tea.Quit
-		case "left", "h":
-			m.Prev()
-		case "right", "l":
-			m.Next()
-		case "enter":
-			return m, m.MoveToNext
-		case "d":
-			return m, m.DeleteTask
-		case "n":
-			models[model] = m // save current
-			models[form] = NewForm(m.focused)
-			return models[form].Update(nil)
-		}
-	}
-
-	var cmd tea.Cmd
-	m.lists[m.focused], cmd = m.lists[m.focused].Update(msg)
-	return m, cmd
-}
-
-func (m Model) View() string {
-	if m.quitting {
-		return ""
-	}
-
-	if m.loaded {
-		todoView := m.lists[todo].View()
-		inProgView := m.lists[inProgress].View()
-		doneView := m.lists[done].View()
-
-		switch m.focused {
-		case inProgress:
-			return lipgloss.JoinHorizontal(lipgloss.Left,
-				columnStyle.Render(todoView),
-				focusedStyle.Render(inProgView),
-				columnStyle.Render(doneView),
-			)
-		case done:
-			return lipgloss.JoinHorizontal(lipgloss.Left,
-				columnStyle.Render(todoView),
-				columnStyle.Render(inProgView),
-				focusedStyle.Render(doneView),
-			)
-		default:
-			return lipgloss.JoinHorizontal(lipgloss.Left,
-				focusedStyle.Render(todoView),
-				columnStyle.Render(inProgView),
-				columnStyle.Render(doneView),
-			)
-		}
-	}
-
-	return "loading..."
-}
-
-// Form model
-type Form struct {
-	focused     status
-	title       textinput.Model
-	description textarea.Model
-}
-
-func NewForm(focused status) *Form {
-	form := &Form{focused: focused}
-	form.title = textinput.New()
-	form.title.Focus()
-	form.description = textarea.New()
-	return form
-}
-
-func (m Form) CreateTask() tea.Msg {
-	return NewTask(m.focused, m.title.Value(), m.description.Value())
-}
-
-func (m Form) Init() tea.Cmd { return nil }
-
-func (m Form) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
-			return m, tea.Quit
-		case "enter":
-			if m.title.Focused() {
-				m.title.Blur()
-				m.description.Focus()
-				return m, textarea.Blink
-			} else {
-				models[form] = m
-				return models[model], m.CreateTask
-			}
-		}
-	}
-
-	var cmd tea.Cmd
-	if m.title.Focused() {
-		m.title, cmd = m.title.Update(msg)
-		return m, cmd
-	} else {
-		m.description, cmd = m.description.Update(msg)
-		return m, cmd
-	}
-}
-
-func (m Form) View() string {
-	return lipgloss.JoinVertical(lipgloss.Left, m.title.View(), m.description.View())
-}
-
-func main() {
-	models = []tea.Model{New(), NewForm(todo)}
-	m := models[model]
-	p := tea.NewProgram(m)
-	if err := p.Start(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
-	}
-}
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alizaso97/kancli/storage"
+	"github.com/alizaso97/kancli/views"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+)
+
+// saveDebounce is how long Model waits after a mutation before flushing
+// the board to the Store, so a burst of moves/deletes only costs one
+// write.
+const saveDebounce = 250 * time.Millisecond
+
+// Styling
+var (
+	columnStyle = lipgloss.NewStyle().
+			Padding(1, 2)
+	focusedStyle = lipgloss.NewStyle().
+			Padding(1, 2).
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("62"))
+	warningStyle = lipgloss.NewStyle().
+			Padding(0, 2).
+			Foreground(lipgloss.Color("204"))
+)
+
+// Task definition
+type Task struct {
+	id          string
+	columnID    string
+	title       string
+	description string
+	priority    string
+	tags        []string
+	dueDate     string
+	assignee    string
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+func NewTask(columnID, title, description string) Task {
+	now := time.Now()
+	return Task{
+		id:          uuid.NewString(),
+		columnID:    columnID,
+		title:       title,
+		description: description,
+		createdAt:   now,
+		updatedAt:   now,
+	}
+}
+
+// MoveTo reassigns the task to another column.
+func (t *Task) MoveTo(columnID string) {
+	t.columnID = columnID
+	t.updatedAt = time.Now()
+}
+
+// toRecord converts a Task to its persisted form.
+func (t Task) toRecord() storage.Task {
+	return storage.Task{
+		ID:          t.id,
+		Column:      t.columnID,
+		Title:       t.title,
+		Description: t.description,
+		Priority:    t.priority,
+		Tags:        t.tags,
+		DueDate:     t.dueDate,
+		Assignee:    t.assignee,
+		CreatedAt:   t.createdAt.Unix(),
+		UpdatedAt:   t.updatedAt.Unix(),
+	}
+}
+
+// taskFromRecord reconstructs a Task from its persisted form.
+func taskFromRecord(r storage.Task) Task {
+	return Task{
+		id:          r.ID,
+		columnID:    r.Column,
+		title:       r.Title,
+		description: r.Description,
+		priority:    r.Priority,
+		tags:        r.Tags,
+		dueDate:     r.DueDate,
+		assignee:    r.Assignee,
+		createdAt:   time.Unix(r.CreatedAt, 0),
+		updatedAt:   time.Unix(r.UpdatedAt, 0),
+	}
+}
+
+// Implement list.Item interface
+func (t Task) FilterValue() string { return t.title }
+func (t Task) Title() string       { return t.title }
+func (t Task) Description() string { return t.description }
+
+// Main model
+type Model struct {
+	loaded   bool
+	focused  int // index into lists/columns
+	lists    []list.Model
+	columns  []storage.Column
+	width    int
+	height   int
+	warning  string
+	quitting bool
+
+	renaming    bool
+	renameInput textinput.Model
+
+	boardID string
+	store   storage.Store
+	watchCh <-chan []storage.Task
+	cancel  context.CancelFunc
+	saveGen int
+
+	undoStack []Op
+	redoStack []Op
+
+	stack *views.Stack
+}
+
+// New main model, backed by store for persistence. boardID identifies
+// which board's column layout to load; stack lets the board push the
+// task form and pop back to the board list.
+func New(store storage.Store, boardID string, stack *views.Stack) *Model {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Model{
+		store:   store,
+		boardID: boardID,
+		watchCh: store.Watch(ctx),
+		cancel:  cancel,
+		stack:   stack,
+	}
+}
+
+// saveTickMsg flushes the board to the Store once its generation is
+// still current, i.e. no newer mutation has superseded it.
+type saveTickMsg struct{ gen int }
+
+// scheduleSave debounces a board write: each call bumps the generation,
+// and only the tick carrying the latest generation actually saves.
+func (m *Model) scheduleSave() tea.Cmd {
+	m.saveGen++
+	gen := m.saveGen
+	return tea.Tick(saveDebounce, func(time.Time) tea.Msg {
+		return saveTickMsg{gen: gen}
+	})
+}
+
+// collectTasks flattens every list into its persisted form.
+func (m *Model) collectTasks() []storage.Task {
+	var tasks []storage.Task
+	for _, l := range m.lists {
+		for _, item := range l.Items() {
+			tasks = append(tasks, item.(Task).toRecord())
+		}
+	}
+	return tasks
+}
+
+// save serializes every list into the Store.
+func (m *Model) save() error {
+	return m.store.Save(m.collectTasks())
+}
+
+// externalBoardMsg carries a board reloaded because another process
+// changed the file Store watches.
+type externalBoardMsg []storage.Task
+
+// waitForWatch listens for the next externally-triggered reload.
+func (m *Model) waitForWatch() tea.Cmd {
+	return func() tea.Msg {
+		tasks, ok := <-m.watchCh
+		if !ok {
+			return nil
+		}
+		return externalBoardMsg(tasks)
+	}
+}
+
+// columnIndex finds the position of columnID, or -1 if it no longer
+// exists (e.g. the column was deleted by another process).
+func (m *Model) columnIndex(columnID string) int {
+	for i, c := range m.columns {
+		if c.ID == columnID {
+			return i
+		}
+	}
+	return -1
+}
+
+// loadTasks seeds m.lists from records, grouping by column.
+func (m *Model) loadTasks(records []storage.Task) {
+	for i := range m.lists {
+		m.lists[i].SetItems(nil)
+	}
+	for _, r := range records {
+		t := taskFromRecord(r)
+		idx := m.columnIndex(t.columnID)
+		if idx < 0 {
+			continue
+		}
+		l := &m.lists[idx]
+		l.InsertItem(len(l.Items()), t)
+	}
+}
+
+// upsertTask inserts t, replacing any existing task with the same ID
+// (an edit-in-place resubmission) wherever it currently lives.
+func (m *Model) upsertTask(t Task) {
+	for i := range m.lists {
+		for j, item := range m.lists[i].Items() {
+			if existing, ok := item.(Task); ok && existing.id == t.id {
+				m.lists[i].RemoveItem(j)
+				break
+			}
+		}
+	}
+
+	idx := m.columnIndex(t.columnID)
+	if idx < 0 {
+		idx = m.focused
+	}
+	m.lists[idx].InsertItem(len(m.lists[idx].Items()), t)
+}
+
+// taskExists reports whether a task with id is already on the board, so
+// callers can tell a creation from an edit-in-place resubmission.
+func (m *Model) taskExists(id string) bool {
+	for _, l := range m.lists {
+		for _, item := range l.Items() {
+			if t, ok := item.(Task); ok && t.id == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeTask deletes the task with id wherever it lives and reports the
+// index it was removed from, for Op.Undo/Redo to restore it precisely.
+func (m *Model) removeTask(id string) (Task, int, bool) {
+	for i := range m.lists {
+		for j, item := range m.lists[i].Items() {
+			if t, ok := item.(Task); ok && t.id == id {
+				m.lists[i].RemoveItem(j)
+				return t, j, true
+			}
+		}
+	}
+	return Task{}, -1, false
+}
+
+// insertTask places t into columnID at index, clamping to the end of
+// the list when index is out of range.
+func (m *Model) insertTask(t Task, columnID string, index int) {
+	idx := m.columnIndex(columnID)
+	if idx < 0 {
+		return
+	}
+	if index < 0 || index > len(m.lists[idx].Items()) {
+		index = len(m.lists[idx].Items())
+	}
+	t.columnID = columnID
+	m.lists[idx].InsertItem(index, t)
+}
+
+// columnWidth divides the terminal width evenly across however many
+// columns the board currently has.
+func columnWidth(width, columns int) int {
+	if columns == 0 {
+		return width
+	}
+	return width / columns
+}
+
+// recomputeLayout resizes every list after a resize or a column
+// add/remove.
+func (m *Model) recomputeLayout() {
+	w := columnWidth(m.width, len(m.columns))
+	h := m.height / 2
+	for i := range m.lists {
+		m.lists[i].SetSize(w, h)
+	}
+}
+
+// Move task to the next column
+func (m *Model) MoveToNext() tea.Cmd {
+	selectedItem := m.lists[m.focused].SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+	selectedTask := selectedItem.(Task)
+
+	next := (m.focused + 1) % len(m.columns)
+	if limit := m.columns[next].WIPLimit; limit > 0 && len(m.lists[next].Items()) >= limit {
+		m.warning = fmt.Sprintf("%q is at its WIP limit (%d)", m.columns[next].Title, limit)
+		return nil
+	}
+	m.warning = ""
+
+	fromCol := selectedTask.columnID
+	t, fromIdx, _ := m.removeTask(selectedTask.id)
+	t.MoveTo(m.columns[next].ID)
+	m.insertTask(t, m.columns[next].ID, -1)
+	toIdx := len(m.lists[next].Items()) - 1
+
+	// Switch focus to the new list
+	m.focused = next
+
+	m.pushOp(MoveOp{taskID: t.id, fromCol: fromCol, fromIdx: fromIdx, toCol: m.columns[next].ID, toIdx: toIdx})
+	return m.scheduleSave()
+}
+
+// Delete selected task
+func (m *Model) DeleteTask() tea.Cmd {
+	selectedItem := m.lists[m.focused].SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+	t, idx, _ := m.removeTask(selectedItem.(Task).id)
+	m.pushOp(DeleteOp{task: t, index: idx})
+	return m.scheduleSave()
+}
+
+// Change focus
+func (m *Model) Next() {
+	m.focused = (m.focused + 1) % len(m.columns)
+}
+func (m *Model) Prev() {
+	m.focused = (m.focused - 1 + len(m.columns)) % len(m.columns)
+}
+
+// AddColumn appends a new, empty column and persists the layout.
+func (m *Model) AddColumn() {
+	col := storage.Column{ID: uuid.NewString(), Title: "New Column"}
+	m.columns = append(m.columns, col)
+
+	l := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	l.SetShowHelp(false)
+	l.Title = col.Title
+	m.lists = append(m.lists, l)
+
+	m.recomputeLayout()
+	saveBoardConfig(m.boardID, BoardConfig{Columns: m.columns})
+}
+
+// RemoveColumn deletes the focused column, refusing when it still holds
+// tasks or is the board's last column.
+func (m *Model) RemoveColumn() {
+	if len(m.columns) <= 1 {
+		m.warning = "a board needs at least one column"
+		return
+	}
+	if len(m.lists[m.focused].Items()) > 0 {
+		m.warning = fmt.Sprintf("%q still has tasks; move or delete them first", m.columns[m.focused].Title)
+		return
+	}
+
+	m.columns = append(m.columns[:m.focused], m.columns[m.focused+1:]...)
+	m.lists = append(m.lists[:m.focused], m.lists[m.focused+1:]...)
+	if m.focused >= len(m.columns) {
+		m.focused = len(m.columns) - 1
+	}
+
+	m.recomputeLayout()
+	saveBoardConfig(m.boardID, BoardConfig{Columns: m.columns})
+	m.warning = ""
+}
+
+// startRenaming opens the inline rename prompt for the focused column.
+func (m *Model) startRenaming() tea.Cmd {
+	m.renameInput = textinput.New()
+	m.renameInput.SetValue(m.columns[m.focused].Title)
+	m.renameInput.Focus()
+	m.renaming = true
+	return textinput.Blink
+}
+
+// commitRename applies the rename prompt's value to the focused column.
+func (m *Model) commitRename() {
+	title := m.renameInput.Value()
+	m.columns[m.focused].Title = title
+	m.lists[m.focused].Title = title
+	saveBoardConfig(m.boardID, BoardConfig{Columns: m.columns})
+	m.renaming = false
+	m.renameInput.Blur()
+}
+
+// Initialize lists (seeded from the Store) and disable the default help
+// panel.
+func (m *Model) initLists(width, height int) {
+	cfg, err := loadBoardConfig(m.boardID)
+	if err != nil {
+		cfg = BoardConfig{Columns: defaultColumns()}
+	}
+	m.columns = cfg.Columns
+
+	listWidth := columnWidth(width, len(m.columns))
+	listHeight := height / 2 // start smaller, adjust dynamically
+	m.lists = make([]list.Model, len(m.columns))
+	for i, col := range m.columns {
+		m.lists[i] = list.New([]list.Item{}, list.NewDefaultDelegate(), listWidth, listHeight)
+		m.lists[i].SetShowHelp(false) // REMOVE the default help/menu
+		m.lists[i].Title = col.Title
+	}
+
+	if records, err := m.store.Load(); err == nil {
+		m.loadTasks(records)
+	}
+}
+
+func (m Model) Init() tea.Cmd { return m.waitForWatch() }
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		if !m.loaded {
+			m.initLists(msg.Width, msg.Height)
+			m.loaded = true
+		} else {
+			m.recomputeLayout()
+		}
+	case tea.KeyMsg:
+		if m.renaming {
+			switch msg.String() {
+			case "esc":
+				m.renaming = false
+				m.renameInput.Blur()
+				return m, nil
+			case "enter":
+				m.commitRename()
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.renameInput, cmd = m.renameInput.Update(msg)
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "ctrl+c":
+			m.quitting = true
+			m.cancel()
+			return m, tea.Quit
+		case "esc":
+			if m.lists[m.focused].FilterState() != list.Unfiltered {
+				break // let the list handle canceling its own filter
+			}
+			m.cancel()
+			return m.stack.Pop(), nil
+		case "left", "h":
+			m.Prev()
+		case "right", "l":
+			m.Next()
+		case "enter":
+			if task, ok := m.lists[m.focused].SelectedItem().(Task); ok {
+				detail := NewDetail(task, m.width, m.height, m.stack)
+				m.stack.Push(detail)
+				return detail, detail.Init()
+			}
+			return m, nil
+		case "m":
+			return m, m.MoveToNext()
+		case "d":
+			return m, m.DeleteTask()
+		case "n":
+			form := NewForm(m.columns[m.focused].ID, m.stack)
+			m.stack.Push(form)
+			return form, form.Init()
+		case "+":
+			m.AddColumn()
+			return m, nil
+		case "-":
+			m.RemoveColumn()
+			return m, nil
+		case "r":
+			return m, m.startRenaming()
+		case "ctrl+e":
+			export := NewExportView(m.stack)
+			m.stack.Push(export)
+			return export, export.Init()
+		case "ctrl+i":
+			importView := NewImportView(m.stack)
+			m.stack.Push(importView)
+			return importView, importView.Init()
+		case "ctrl+p":
+			palette := NewPalette(m, m.stack)
+			m.stack.Push(palette)
+			return palette, palette.Init()
+		case "u":
+			return m, m.Undo()
+		case "ctrl+r":
+			return m, m.Redo()
+		}
+	case Task:
+		existed := m.taskExists(msg.id)
+		m.upsertTask(msg)
+		if !existed {
+			m.pushOp(CreateOp{task: msg})
+		}
+		return m, m.scheduleSave()
+	case saveTickMsg:
+		if msg.gen == m.saveGen {
+			m.save()
+		}
+		return m, nil
+	case externalBoardMsg:
+		m.loadTasks([]storage.Task(msg))
+		m.redoStack = nil // the on-disk change forked the timeline
+		return m, m.waitForWatch()
+	case exportMsg, importMsg:
+		return m, m.applyExportImport(msg)
+	case focusTaskMsg:
+		m.focusTask(msg.taskID)
+		return m, nil
+	case paletteMoveMsg:
+		m.moveTaskTo(msg.taskID, msg.columnID)
+		return m, m.scheduleSave()
+	case paletteDeleteMsg:
+		m.deleteTaskByID(msg.taskID)
+		return m, m.scheduleSave()
+	}
+
+	var cmd tea.Cmd
+	m.lists[m.focused], cmd = m.lists[m.focused].Update(msg)
+	return m, cmd
+}
+
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+	if !m.loaded {
+		return "loading..."
+	}
+
+	cols := make([]string, len(m.lists))
+	for i, l := range m.lists {
+		style := columnStyle
+		if i == m.focused {
+			style = focusedStyle
+		}
+		cols[i] = style.Render(l.View())
+	}
+	board := lipgloss.JoinHorizontal(lipgloss.Left, cols...)
+
+	switch {
+	case m.renaming:
+		return lipgloss.JoinVertical(lipgloss.Left, board, "Rename column: "+m.renameInput.View())
+	case m.warning != "":
+		return lipgloss.JoinVertical(lipgloss.Left, board, warningStyle.Render(m.warning))
+	default:
+		return board
+	}
+}
+
+// App delegates Init/Update/View to whatever view is on top of the
+// stack, so BoardList, Model (a board), and Form can push and pop each
+// other without main needing to track which one is active.
+type App struct {
+	stack *views.Stack
+}
+
+func (a App) Init() tea.Cmd {
+	return a.stack.Current().Init()
+}
+
+func (a App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := a.stack.Current().Update(msg)
+	a.stack.Replace(next)
+	return a, cmd
+}
+
+func (a App) View() string {
+	return a.stack.Current().View()
+}
+
+// boardFormat chooses the on-disk format for every board, honoring
+// --yaml to switch from JSON to YAML.
+func boardFormat() storage.Format {
+	for _, arg := range os.Args[1:] {
+		if arg == "--yaml" {
+			return storage.FormatYAML
+		}
+	}
+	return storage.FormatJSON
+}
+
+func main() {
+	stack := views.NewStack(nil)
+	boardList := NewBoardList(stack, boardFormat())
+	stack.Replace(boardList)
+
+	p := tea.NewProgram(App{stack: stack})
+	if err := p.Start(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+// Op is a reversible board mutation recorded on Model's undo stack.
+type Op interface {
+	Undo(m *Model)
+	Redo(m *Model)
+}
+
+// MoveOp reverses or replays a task moving from one column to another,
+// restoring it to its exact prior or subsequent index.
+type MoveOp struct {
+	taskID  string
+	fromCol string
+	fromIdx int
+	toCol   string
+	toIdx   int
+}
+
+func (op MoveOp) Undo(m *Model) {
+	t, _, ok := m.removeTask(op.taskID)
+	if !ok {
+		return
+	}
+	m.insertTask(t, op.fromCol, op.fromIdx)
+}
+
+func (op MoveOp) Redo(m *Model) {
+	t, _, ok := m.removeTask(op.taskID)
+	if !ok {
+		return
+	}
+	m.insertTask(t, op.toCol, op.toIdx)
+}
+
+// DeleteOp reverses or replays deleting a task, keeping the full record
+// so Undo can restore it at its original index.
+type DeleteOp struct {
+	task  Task
+	index int
+}
+
+func (op DeleteOp) Undo(m *Model) {
+	m.insertTask(op.task, op.task.columnID, op.index)
+}
+
+func (op DeleteOp) Redo(m *Model) {
+	m.removeTask(op.task.id)
+}
+
+// CreateOp reverses or replays creating a task from the task form.
+type CreateOp struct {
+	task Task
+}
+
+func (op CreateOp) Undo(m *Model) {
+	m.removeTask(op.task.id)
+}
+
+func (op CreateOp) Redo(m *Model) {
+	m.insertTask(op.task, op.task.columnID, -1)
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alizaso97/kancli/storage"
+	"github.com/alizaso97/kancli/views"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// paletteEntry is one fuzzy-searchable task, remembered alongside the
+// title of the column it lives in.
+type paletteEntry struct {
+	task        Task
+	columnTitle string
+}
+
+// Palette is a ctrl+p overlay: a textinput fuzzy-matched against every
+// task on the board, rendered centered via lipgloss.Place on top of the
+// board, which stays untouched underneath it on the view stack. Plain
+// input jumps focus to the chosen task; input starting with ">" is
+// parsed as a command: "move to <column>" and "delete" apply to whichever
+// task is currently highlighted, and "new task in <column>" opens the
+// task form for that column.
+type Palette struct {
+	stack  *views.Stack
+	width  int
+	height int
+
+	columns []storage.Column
+	entries []paletteEntry
+
+	input   textinput.Model
+	matches fuzzy.Matches
+	cursor  int
+}
+
+// NewPalette snapshots board's tasks so the overlay can search
+// independently of further board mutations.
+func NewPalette(board Model, stack *views.Stack) *Palette {
+	p := &Palette{
+		stack:   stack,
+		width:   board.width,
+		height:  board.height,
+		columns: board.columns,
+	}
+	for i, l := range board.lists {
+		title := ""
+		if i < len(board.columns) {
+			title = board.columns[i].Title
+		}
+		for _, item := range l.Items() {
+			if t, ok := item.(Task); ok {
+				p.entries = append(p.entries, paletteEntry{task: t, columnTitle: title})
+			}
+		}
+	}
+
+	p.input = textinput.New()
+	p.input.Placeholder = "search tasks, or >move to done / >delete / >new task in <column>"
+	p.input.Focus()
+	p.refresh()
+	return p
+}
+
+// refresh recomputes the fuzzy matches from the current query. While the
+// query is a command (starts with ">"), the last search results are kept
+// so commands act on whatever was highlighted.
+func (p *Palette) refresh() {
+	query := p.input.Value()
+	if strings.HasPrefix(query, ">") {
+		return
+	}
+
+	titles := make([]string, len(p.entries))
+	for i, e := range p.entries {
+		titles[i] = e.task.title
+	}
+
+	if query == "" {
+		p.matches = make(fuzzy.Matches, len(titles))
+		for i := range titles {
+			p.matches[i] = fuzzy.Match{Str: titles[i], Index: i}
+		}
+	} else {
+		p.matches = fuzzy.Find(query, titles)
+	}
+	p.cursor = 0
+}
+
+// active returns the entry currently highlighted in the results.
+func (p Palette) active() (paletteEntry, bool) {
+	if p.cursor < 0 || p.cursor >= len(p.matches) {
+		return paletteEntry{}, false
+	}
+	return p.entries[p.matches[p.cursor].Index], true
+}
+
+// columnIDByTitle resolves a column title typed into a command verb.
+func (p Palette) columnIDByTitle(title string) string {
+	for _, c := range p.columns {
+		if strings.EqualFold(c.Title, title) {
+			return c.ID
+		}
+	}
+	return ""
+}
+
+func (p Palette) Init() tea.Cmd { return textinput.Blink }
+
+func (p Palette) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.String() {
+		case "ctrl+c":
+			return p, tea.Quit
+		case "esc":
+			return p.stack.Pop(), nil
+		case "up":
+			if p.cursor > 0 {
+				p.cursor--
+			}
+			return p, nil
+		case "down":
+			if p.cursor < len(p.matches)-1 {
+				p.cursor++
+			}
+			return p, nil
+		case "enter":
+			return p.run()
+		}
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	p.refresh()
+	return p, cmd
+}
+
+// run dispatches the current input: a command verb if it starts with
+// ">", otherwise a jump to the highlighted task.
+func (p Palette) run() (tea.Model, tea.Cmd) {
+	query := p.input.Value()
+	if strings.HasPrefix(query, ">") {
+		return p.runCommand(strings.TrimSpace(strings.TrimPrefix(query, ">")))
+	}
+
+	entry, ok := p.active()
+	if !ok {
+		return p, nil
+	}
+	board := p.stack.Pop()
+	next, cmd := board.Update(focusTaskMsg{taskID: entry.task.id})
+	p.stack.Replace(next)
+	return p.stack.Current(), cmd
+}
+
+func (p Palette) runCommand(verb string) (tea.Model, tea.Cmd) {
+	switch {
+	case verb == "delete":
+		entry, ok := p.active()
+		if !ok {
+			return p, nil
+		}
+		board := p.stack.Pop()
+		next, cmd := board.Update(paletteDeleteMsg{taskID: entry.task.id})
+		p.stack.Replace(next)
+		return p.stack.Current(), cmd
+
+	case strings.HasPrefix(verb, "move to "):
+		entry, ok := p.active()
+		columnID := p.columnIDByTitle(strings.TrimSpace(strings.TrimPrefix(verb, "move to ")))
+		if !ok || columnID == "" {
+			return p, nil
+		}
+		board := p.stack.Pop()
+		next, cmd := board.Update(paletteMoveMsg{taskID: entry.task.id, columnID: columnID})
+		p.stack.Replace(next)
+		return p.stack.Current(), cmd
+
+	case strings.HasPrefix(verb, "new task in "):
+		columnID := p.columnIDByTitle(strings.TrimSpace(strings.TrimPrefix(verb, "new task in ")))
+		if columnID == "" {
+			return p, nil
+		}
+		p.stack.Pop() // close the palette, leaving the board on top
+		form := NewForm(columnID, p.stack)
+		p.stack.Push(form)
+		return form, form.Init()
+	}
+	return p, nil
+}
+
+func (p Palette) View() string {
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		Padding(1, 2).
+		Width(60).
+		Render(p.input.View() + "\n\n" + p.resultsView())
+	return lipgloss.Place(p.width, p.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+func (p Palette) resultsView() string {
+	if len(p.matches) == 0 {
+		return "no matches"
+	}
+
+	var b strings.Builder
+	for i, match := range p.matches {
+		if i >= 8 {
+			break
+		}
+		entry := p.entries[match.Index]
+		marker := "  "
+		if i == p.cursor {
+			marker = "> "
+		}
+		fmt.Fprintf(&b, "%s%s [%s]\n", marker, entry.task.title, entry.columnTitle)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
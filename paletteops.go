@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+// focusTaskMsg asks the board to move focus to the task named taskID and
+// highlight it, without otherwise changing the board.
+type focusTaskMsg struct{ taskID string }
+
+// paletteMoveMsg asks the board to move a task to another column, as
+// issued by the command palette's ">move to <column>" verb.
+type paletteMoveMsg struct{ taskID, columnID string }
+
+// paletteDeleteMsg asks the board to delete a task, as issued by the
+// command palette's ">delete" verb.
+type paletteDeleteMsg struct{ taskID string }
+
+// focusTask switches focus to taskID's column and selects it.
+func (m *Model) focusTask(taskID string) {
+	for i, l := range m.lists {
+		for j, item := range l.Items() {
+			if t, ok := item.(Task); ok && t.id == taskID {
+				m.focused = i
+				m.lists[i].Select(j)
+				return
+			}
+		}
+	}
+}
+
+// moveTaskTo relocates taskID to columnID, wherever it currently lives,
+// recording a reversible MoveOp. Same as MoveToNext, the move is
+// rejected with a warning if columnID is at its WIP limit.
+func (m *Model) moveTaskTo(taskID, columnID string) {
+	idx := m.columnIndex(columnID)
+	if idx < 0 {
+		return
+	}
+	if limit := m.columns[idx].WIPLimit; limit > 0 && len(m.lists[idx].Items()) >= limit {
+		m.warning = fmt.Sprintf("%q is at its WIP limit (%d)", m.columns[idx].Title, limit)
+		return
+	}
+	m.warning = ""
+
+	t, fromIdx, ok := m.removeTask(taskID)
+	if !ok {
+		return
+	}
+	fromCol := t.columnID
+	t.MoveTo(columnID)
+	m.insertTask(t, columnID, -1)
+	toIdx := len(m.lists[idx].Items()) - 1
+	m.pushOp(MoveOp{taskID: taskID, fromCol: fromCol, fromIdx: fromIdx, toCol: columnID, toIdx: toIdx})
+}
+
+// deleteTaskByID removes taskID, wherever it currently lives, recording
+// a reversible DeleteOp.
+func (m *Model) deleteTaskByID(taskID string) {
+	t, idx, ok := m.removeTask(taskID)
+	if !ok {
+		return
+	}
+	m.pushOp(DeleteOp{task: t, index: idx})
+}
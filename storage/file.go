@@ -0,0 +1,229 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+)
+
+// Format selects the on-disk encoding a FileStore uses.
+type Format int
+
+const (
+	FormatJSON Format = iota
+	FormatYAML
+)
+
+// FileStore is the default Store backend: a single JSON (or YAML) file,
+// normally one board's file at BoardPath.
+type FileStore struct {
+	path   string
+	format Format
+
+	mu        sync.Mutex
+	lastSaved []byte // raw bytes of our own most recent Save, so Watch can tell its own write from a real external edit
+}
+
+// NewFileStore returns a FileStore that reads and writes path using
+// format, creating parent directories as needed.
+func NewFileStore(path string, format Format) *FileStore {
+	return &FileStore{path: path, format: format}
+}
+
+// configDir returns $XDG_CONFIG_HOME/kancli, falling back to
+// ~/.config/kancli when XDG_CONFIG_HOME is unset.
+func configDir() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "kancli"), nil
+}
+
+// DefaultPath returns $XDG_CONFIG_HOME/kancli/board.json, the single-board
+// layout used before workspaces existed.
+func DefaultPath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "board.json"), nil
+}
+
+// WorkspacePath returns the path to the workspace's board-list file:
+// $XDG_CONFIG_HOME/kancli/workspace.json.
+func WorkspacePath() (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "workspace.json"), nil
+}
+
+// BoardPath returns where a single board's tasks are stored:
+// $XDG_CONFIG_HOME/kancli/boards/<id>.json.
+func BoardPath(id string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "boards", id+".json"), nil
+}
+
+// BoardConfigPath returns where a single board's column layout is
+// stored: $XDG_CONFIG_HOME/kancli/boards/<id>.columns.json. Kept apart
+// from BoardPath so reordering/renaming/resizing columns never touches
+// task data.
+func BoardConfigPath(id string) (string, error) {
+	dir, err := configDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "boards", id+".columns.json"), nil
+}
+
+func (s *FileStore) Load() ([]Task, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return []Task{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return []Task{}, nil
+	}
+	return s.decode(data)
+}
+
+// decode unmarshals raw file bytes in s.format. Shared by Load and
+// Watch so they can't drift on how a board is parsed.
+func (s *FileStore) decode(data []byte) ([]Task, error) {
+	var tasks []Task
+	var err error
+	if s.format == FormatYAML {
+		err = yaml.Unmarshal(data, &tasks)
+	} else {
+		err = json.Unmarshal(data, &tasks)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (s *FileStore) Save(tasks []Task) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	var data []byte
+	var err error
+	if s.format == FormatYAML {
+		data, err = yaml.Marshal(tasks)
+	} else {
+		data, err = json.MarshalIndent(tasks, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.lastSaved = data
+	s.mu.Unlock()
+	return nil
+}
+
+// isSelfWrite reports whether data is exactly what this Store's own
+// Save most recently wrote, so Watch can tell its own write from a
+// genuine external edit.
+func (s *FileStore) isSelfWrite(data []byte) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSaved != nil && bytes.Equal(data, s.lastSaved)
+}
+
+// Watch follows s.path with fsnotify and re-loads the board whenever it
+// changes, so edits made by another process (or another kancli instance)
+// show up without a restart. Writes that match this Store's own last
+// Save are ignored, so the program's own autosave doesn't echo back to
+// itself as an external change.
+func (s *FileStore) Watch(ctx context.Context) <-chan []Task {
+	out := make(chan []Task)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		close(out)
+		return out
+	}
+	if err := watcher.Add(filepath.Dir(s.path)); err != nil {
+		watcher.Close()
+		close(out)
+		return out
+	}
+
+	go func() {
+		defer watcher.Close()
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != filepath.Clean(s.path) {
+					continue
+				}
+				if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) {
+					continue
+				}
+
+				data, err := os.ReadFile(s.path)
+				if err != nil {
+					continue
+				}
+				if s.isSelfWrite(data) {
+					continue // our own Save, not a real external change
+				}
+				if len(data) == 0 {
+					continue
+				}
+				tasks, err := s.decode(data)
+				if err != nil {
+					continue
+				}
+				select {
+				case out <- tasks:
+				case <-ctx.Done():
+					return
+				}
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
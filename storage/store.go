@@ -0,0 +1,46 @@
+// Package storage persists a kancli board to disk and watches it for
+// changes made by other processes.
+package storage
+
+import "context"
+
+// Task is the serializable form of a board task. It mirrors the fields
+// the main package keeps on its own Task type so that package main never
+// has to export its internal representation just to round-trip JSON/YAML.
+type Task struct {
+	ID          string   `json:"id" yaml:"id"`
+	Column      string   `json:"column" yaml:"column"`
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Priority    string   `json:"priority" yaml:"priority"`
+	Tags        []string `json:"tags" yaml:"tags"`
+	DueDate     string   `json:"dueDate" yaml:"dueDate"`
+	Assignee    string   `json:"assignee" yaml:"assignee"`
+	CreatedAt   int64    `json:"createdAt" yaml:"createdAt"`
+	UpdatedAt   int64    `json:"updatedAt" yaml:"updatedAt"`
+}
+
+// Column is one stage of a board, e.g. "To Do" or "Done". WIPLimit of 0
+// means unlimited.
+type Column struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	WIPLimit int    `json:"wipLimit"`
+}
+
+// Store is the persistence boundary for a board. Implementations decide
+// the on-disk format; callers only deal in Task slices.
+type Store interface {
+	// Load reads the full board. A Store that has never been saved to
+	// returns an empty slice and a nil error.
+	Load() ([]Task, error)
+
+	// Save overwrites the on-disk board with tasks.
+	Save(tasks []Task) error
+
+	// Watch emits the board every time it changes on disk because some
+	// other process wrote to it. The channel is closed when ctx is
+	// done. Stores that can't watch (e.g. in-memory ones) may return a
+	// nil channel.
+	Watch(ctx context.Context) <-chan []Task
+}
@@ -0,0 +1,41 @@
+package main
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// undoDepth caps how many reversible mutations Model remembers.
+const undoDepth = 100
+
+// pushOp records op as the most recent mutation. It forks the timeline,
+// so anything waiting to be redone is discarded.
+func (m *Model) pushOp(op Op) {
+	m.undoStack = append(m.undoStack, op)
+	if len(m.undoStack) > undoDepth {
+		m.undoStack = m.undoStack[len(m.undoStack)-undoDepth:]
+	}
+	m.redoStack = nil
+}
+
+// Undo reverts the most recent mutation, if any, and saves the result.
+func (m *Model) Undo() tea.Cmd {
+	if len(m.undoStack) == 0 {
+		return nil
+	}
+	op := m.undoStack[len(m.undoStack)-1]
+	m.undoStack = m.undoStack[:len(m.undoStack)-1]
+	op.Undo(m)
+	m.redoStack = append(m.redoStack, op)
+	return m.scheduleSave()
+}
+
+// Redo re-applies the most recently undone mutation, if any, and saves
+// the result.
+func (m *Model) Redo() tea.Cmd {
+	if len(m.redoStack) == 0 {
+		return nil
+	}
+	op := m.redoStack[len(m.redoStack)-1]
+	m.redoStack = m.redoStack[:len(m.redoStack)-1]
+	op.Redo(m)
+	m.undoStack = append(m.undoStack, op)
+	return m.scheduleSave()
+}
@@ -0,0 +1,45 @@
+// Package views provides a small view-stack so a bubbletea program can
+// compose screens (board, forms, detail panes, overlays, ...) without a
+// fixed-size global slice indexed by hand.
+package views
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Stack holds the views of a program, last-pushed-on-top.
+type Stack struct {
+	views []tea.Model
+}
+
+// NewStack returns a Stack with root as its only, bottom view.
+func NewStack(root tea.Model) *Stack {
+	return &Stack{views: []tea.Model{root}}
+}
+
+// Push opens v on top of the stack.
+func (s *Stack) Push(v tea.Model) {
+	s.views = append(s.views, v)
+}
+
+// Pop closes the top view and returns the one now on top. The root view
+// is never popped.
+func (s *Stack) Pop() tea.Model {
+	if len(s.views) > 1 {
+		s.views = s.views[:len(s.views)-1]
+	}
+	return s.Current()
+}
+
+// Replace swaps the top view for v, keeping the stack depth unchanged.
+func (s *Stack) Replace(v tea.Model) {
+	s.views[len(s.views)-1] = v
+}
+
+// Current returns the view on top of the stack.
+func (s *Stack) Current() tea.Model {
+	return s.views[len(s.views)-1]
+}
+
+// Len reports how many views are on the stack.
+func (s *Stack) Len() int {
+	return len(s.views)
+}
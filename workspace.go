@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/alizaso97/kancli/storage"
+	"github.com/google/uuid"
+)
+
+// BoardMeta is the workspace's record of one board: just enough to list
+// and open it. The board's own tasks live in their own file, see
+// storage.BoardPath.
+type BoardMeta struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Workspace is the set of boards a user has open.
+type Workspace struct {
+	Boards []BoardMeta `json:"boards"`
+}
+
+func loadWorkspace() (Workspace, error) {
+	path, err := storage.WorkspacePath()
+	if err != nil {
+		return Workspace{}, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return Workspace{}, nil
+	}
+	if err != nil {
+		return Workspace{}, err
+	}
+	var ws Workspace
+	if err := json.Unmarshal(data, &ws); err != nil {
+		return Workspace{}, err
+	}
+	return ws, nil
+}
+
+func saveWorkspace(ws Workspace) error {
+	path, err := storage.WorkspacePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(ws, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// createBoard adds a new, empty board to the workspace and returns it.
+func createBoard(name string) BoardMeta {
+	ws, _ := loadWorkspace()
+	board := BoardMeta{ID: uuid.NewString(), Name: name}
+	ws.Boards = append(ws.Boards, board)
+	saveWorkspace(ws)
+	return board
+}
+
+func renameBoard(id, name string) {
+	ws, _ := loadWorkspace()
+	for i := range ws.Boards {
+		if ws.Boards[i].ID == id {
+			ws.Boards[i].Name = name
+		}
+	}
+	saveWorkspace(ws)
+}
+
+func deleteBoard(id string) {
+	ws, _ := loadWorkspace()
+	kept := ws.Boards[:0]
+	for _, b := range ws.Boards {
+		if b.ID != id {
+			kept = append(kept, b)
+		}
+	}
+	ws.Boards = kept
+	saveWorkspace(ws)
+
+	if path, err := storage.BoardPath(id); err == nil {
+		os.Remove(path)
+	}
+}